@@ -50,6 +50,9 @@ func waitOnOne(serviceClient workflowpkg.WorkflowServiceClient, ctx context.Cont
 	}
 	stream, err := serviceClient.WatchWorkflows(ctx, req)
 	if err != nil {
+		if timedOut(ctx, wfName, quiet) {
+			return false
+		}
 		if status.Code(err) == codes.NotFound && ignoreNotFound {
 			return true
 		}
@@ -58,25 +61,45 @@ func waitOnOne(serviceClient workflowpkg.WorkflowServiceClient, ctx context.Cont
 	}
 	for {
 		event, err := stream.Recv()
+		if err == nil {
+			if event == nil {
+				continue
+			}
+			wf := event.Object
+			if wf != nil && !wf.Status.FinishedAt.IsZero() {
+				if !quiet {
+					fmt.Printf("%s %s at %v\n", wfName, wf.Status.Phase, wf.Status.FinishedAt)
+				}
+				if wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
+					return false
+				}
+				return true
+			}
+			continue
+		}
+		if timedOut(ctx, wfName, quiet) {
+			return false
+		}
 		if err == io.EOF {
 			log.Debug("Re-establishing workflow watch")
 			stream, err = serviceClient.WatchWorkflows(ctx, req)
+			if err != nil && timedOut(ctx, wfName, quiet) {
+				return false
+			}
 			errors.CheckError(err)
 			continue
 		}
 		errors.CheckError(err)
-		if event == nil {
-			continue
-		}
-		wf := event.Object
-		if wf != nil && !wf.Status.FinishedAt.IsZero() {
-			if !quiet {
-				fmt.Printf("%s %s at %v\n", wfName, wf.Status.Phase, wf.Status.FinishedAt)
-			}
-			if wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
-				return false
-			}
-			return true
-		}
 	}
 }
+
+// timedOut reports whether the wait for wfName ran past the --timeout deadline
+func timedOut(ctx context.Context, wfName string, quiet bool) bool {
+	if ctx.Err() != context.DeadlineExceeded {
+		return false
+	}
+	if !quiet {
+		fmt.Printf("%s timed out waiting for completion\n", wfName)
+	}
+	return true
+}
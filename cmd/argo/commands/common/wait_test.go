@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// runningWorkflowStream reports a still-running workflow, then blocks until its context is done
+type runningWorkflowStream struct {
+	grpc.ClientStream
+	ctx  context.Context
+	sent bool
+}
+
+func (s *runningWorkflowStream) Recv() (*workflowpkg.WorkflowWatchEvent, error) {
+	if !s.sent {
+		s.sent = true
+		return &workflowpkg.WorkflowWatchEvent{Object: &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}}}, nil
+	}
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func Test_waitOnOne_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	serviceClient := &mocks.WorkflowServiceClient{}
+	serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).Return(&runningWorkflowStream{ctx: ctx}, nil)
+	assert.False(t, waitOnOne(serviceClient, ctx, "my-wf", "my-ns", false, true))
+}
+
+// lateStream waits until its context is done, then returns the given event and error
+type lateStream struct {
+	grpc.ClientStream
+	ctx   context.Context
+	event *workflowpkg.WorkflowWatchEvent
+	err   error
+}
+
+func (s *lateStream) Recv() (*workflowpkg.WorkflowWatchEvent, error) {
+	<-s.ctx.Done()
+	return s.event, s.err
+}
+
+func Test_waitOnOne_CompletedAtDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	event := &workflowpkg.WorkflowWatchEvent{Object: &wfv1.Workflow{Status: wfv1.WorkflowStatus{
+		Phase:      wfv1.WorkflowSucceeded,
+		FinishedAt: metav1.Now(),
+	}}}
+	serviceClient := &mocks.WorkflowServiceClient{}
+	serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).Return(&lateStream{ctx: ctx, event: event}, nil)
+	assert.True(t, waitOnOne(serviceClient, ctx, "my-wf", "my-ns", false, true))
+}
+
+func Test_waitOnOne_TimeoutOnReconnect(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	serviceClient := &mocks.WorkflowServiceClient{}
+	// the watch closes before the deadline, and re-establishing it outlasts the deadline
+	closed, done := context.WithCancel(context.Background())
+	done()
+	serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).Return(&lateStream{ctx: closed, err: io.EOF}, nil).Once()
+	serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { <-ctx.Done() }).
+		Return(nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")).Once()
+	assert.False(t, waitOnOne(serviceClient, ctx, "my-wf", "my-ns", false, true))
+	serviceClient.AssertNumberOfCalls(t, "WatchWorkflows", 2)
+}
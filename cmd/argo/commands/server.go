@@ -190,7 +190,7 @@ See %s`, help.ArgoServer),
 		},
 	}
 
-	command.Flags().IntVarP(&port, "port", "p", 2746, "Port to listen on")
+	command.Flags().IntVarP(&port, "port", "p", 2746, "Port to listen on, or 0 to pick a free port")
 	command.Flags().StringVar(&baseHRef, "base-href", "/", "Value for base href in index.html. Used if the server is running behind reverse proxy under subpath different from /.")
 	// "-e" for encrypt, like zip
 	command.Flags().BoolVarP(&secure, "secure", "e", true, "Whether or not we should listen on TLS.")
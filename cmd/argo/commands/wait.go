@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"context"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
@@ -8,7 +11,10 @@ import (
 )
 
 func NewWaitCommand() *cobra.Command {
-	var ignoreNotFound bool
+	var (
+		ignoreNotFound bool
+		timeout        time.Duration
+	)
 	command := &cobra.Command{
 		Use:   "wait [WORKFLOW...]",
 		Short: "waits for workflows to complete",
@@ -19,14 +25,24 @@ func NewWaitCommand() *cobra.Command {
 # Wait on the latest workflow:
 
   argo wait @latest
+
+# Give up, exiting non-zero, if the workflow has not completed within 10 minutes:
+
+  argo wait my-wf --timeout 10m
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
 			common.WaitWorkflows(ctx, serviceClient, namespace, args, ignoreNotFound, false)
 		},
 	}
 	command.Flags().BoolVar(&ignoreNotFound, "ignore-not-found", false, "Ignore the wait if the workflow is not found")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "Exit non-zero if the workflows have not completed within this duration, e.g. 30s, 10m. Defaults to waiting forever.")
 	return command
 }
@@ -3,6 +3,7 @@ package commands
 import (
 	"compress/gzip"
 	"context"
+	"debug/elf"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
@@ -224,6 +226,35 @@ func NewEmissaryCommand() *cobra.Command {
 	}
 }
 
+// elfArchs maps ELF machines to GOARCH values
+var elfArchs = map[elf.Machine]string{
+	elf.EM_386:     "386",
+	elf.EM_X86_64:  "amd64",
+	elf.EM_ARM:     "arm",
+	elf.EM_AARCH64: "arm64",
+	elf.EM_PPC64:   "ppc64le",
+	elf.EM_S390:    "s390x",
+	elf.EM_RISCV:   "riscv64",
+}
+
+// elfArch returns the GOARCH the ELF binary at path was built for, or "" if it is not an ELF binary.
+// Machines without a GOARCH are returned by their ELF name, e.g. "EM_MIPS".
+func elfArch(path string) string {
+	f, err := elf.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = f.Close() }()
+	if f.Machine == elf.EM_PPC64 && f.Data == elf.ELFDATA2MSB {
+		return "ppc64"
+	}
+	arch, ok := elfArchs[f.Machine]
+	if !ok {
+		return f.Machine.String()
+	}
+	return arch
+}
+
 func startCommand(name string, args []string, template *wfv1.Template) (*exec.Cmd, func(), error) {
 	command := exec.Command(name, args...)
 	command.Env = os.Environ()
@@ -257,6 +288,11 @@ func startCommand(name string, args []string, template *wfv1.Template) (*exec.Cm
 
 	cmdCloser, err := osspecific.StartCommand(command)
 	if err != nil {
+		if errors.IsExecFormatErr(err) {
+			if arch := elfArch(command.Path); arch != "" && arch != runtime.GOARCH {
+				return nil, nil, fmt.Errorf("%w: %s is a %s/%s binary but this node is %s/%s, choose a multi-arch image or schedule onto a node with a matching architecture", err, name, runtime.GOOS, arch, runtime.GOOS, runtime.GOARCH)
+			}
+		}
 		return nil, nil, err
 	}
 
@@ -3,8 +3,13 @@
 package commands
 
 import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
 	"fmt"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strconv"
 	"sync"
 	"syscall"
@@ -62,6 +67,44 @@ func TestEmissary(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, string(data), "hello")
 	})
+	t.Run("ExecFormatError", func(t *testing.T) {
+		// an ELF executable header for an architecture other than this node's
+		machine, arch := elf.EM_AARCH64, "arm64"
+		if runtime.GOARCH == "arm64" {
+			machine, arch = elf.EM_X86_64, "amd64"
+		}
+		header := elf.Header64{
+			Type:      uint16(elf.ET_EXEC),
+			Machine:   uint16(machine),
+			Version:   uint32(elf.EV_CURRENT),
+			Ehsize:    64,
+			Phentsize: 56,
+			Shentsize: 64,
+		}
+		copy(header.Ident[:], elf.ELFMAG)
+		header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+		header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+		header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+		buf := &bytes.Buffer{}
+		assert.NoError(t, binary.Write(buf, binary.LittleEndian, header))
+		name := filepath.Join(tmp, "other-arch")
+		assert.NoError(t, os.WriteFile(name, buf.Bytes(), 0o700))
+		cmd := NewEmissaryCommand()
+		containerName = "main"
+		err = cmd.RunE(cmd, []string{name})
+		assert.True(t, errors.IsExecFormatErr(err))
+		assert.ErrorContains(t, err, fmt.Sprintf("is a %s/%s binary but this node is %s/%s", runtime.GOOS, arch, runtime.GOOS, runtime.GOARCH))
+	})
+	t.Run("ExecFormatErrorNotELF", func(t *testing.T) {
+		// e.g. a script without a shebang
+		name := filepath.Join(tmp, "no-shebang")
+		assert.NoError(t, os.WriteFile(name, []byte{0x00, 0x01, 0x02, 0x03}, 0o700))
+		cmd := NewEmissaryCommand()
+		containerName = "main"
+		err = cmd.RunE(cmd, []string{name})
+		assert.True(t, errors.IsExecFormatErr(err))
+		assert.NotContains(t, err.Error(), "binary but this node is")
+	})
 	t.Run("Signal", func(t *testing.T) {
 		for signal := range map[syscall.Signal]string{
 			syscall.SIGTERM: "terminated",
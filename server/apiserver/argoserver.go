@@ -41,6 +41,8 @@ import (
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/apiserver/accesslog"
+	"github.com/argoproj/argo-workflows/v3/server/apiserver/compress"
+	"github.com/argoproj/argo-workflows/v3/server/apiserver/etag"
 	"github.com/argoproj/argo-workflows/v3/server/artifacts"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
@@ -239,16 +241,14 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	resourceCacheNamespace := getResourceCacheNamespace(as.managedNamespace)
 	workflowServer := workflow.NewWorkflowServer(instanceIDService, offloadRepo, wfArchive, as.clients.Workflow, wfStore, wfStore, &resourceCacheNamespace)
 	grpcServer := as.newGRPCServer(instanceIDService, workflowServer, wfArchiveServer, eventServer, config.Links, config.Columns, config.NavColor)
-	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
 	var conn net.Listener
 	var listerErr error
-	address := fmt.Sprintf(":%d", port)
 	err = wait.ExponentialBackoff(backoff, func() (bool, error) {
-		conn, listerErr = net.Listen("tcp", address)
+		conn, listerErr = net.Listen("tcp", fmt.Sprintf(":%d", port))
 		if listerErr != nil {
-			log.WithError(err).Warn("failed to listen")
+			log.WithError(listerErr).Warn("failed to listen")
 			return false, nil
 		}
 		return true, nil
@@ -257,6 +257,10 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		log.Error(err)
 		return
 	}
+	// when listening on port 0, the OS picks a free port, which the HTTP gateway must dial and we must report
+	port = conn.Addr().(*net.TCPAddr).Port
+	address := fmt.Sprintf(":%d", port)
+	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	if as.tlsConfig != nil {
 		conn = tls.NewListener(conn, as.tlsConfig)
@@ -386,11 +390,11 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(workflowarchivepkg.RegisterArchivedWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 
-	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+	mux.Handle("/api/", compress.Interceptor(etag.Interceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// we must delete this header for API request to prevent "stream terminated by RST_STREAM with error code: PROTOCOL_ERROR" error
 		r.Header.Del("Connection")
 		webhookInterceptor(w, r, gwmux)
-	})
+	}))))
 
 	// emergency environment variable that allows you to disable the artifact service in case of problems
 	if os.Getenv("ARGO_ARTIFACT_SERVER") != "false" {
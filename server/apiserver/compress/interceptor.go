@@ -0,0 +1,29 @@
+package compress
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/handlers"
+)
+
+// Interceptor returns a handler that gzips responses when the client sends `Accept-Encoding: gzip`.
+// Streaming endpoints (server-sent events and logs) are passed through untouched, so that events are not held
+// back in the compressor's buffer.
+func Interceptor(h http.Handler) http.Handler {
+	compressed := handlers.CompressHandler(h)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStream(r) {
+			h.ServeHTTP(w, r)
+		} else {
+			compressed.ServeHTTP(w, r)
+		}
+	})
+}
+
+func isStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream") ||
+		strings.HasPrefix(r.URL.Path, "/api/v1/stream/") ||
+		strings.HasPrefix(r.URL.Path, "/api/v1/workflow-events/") ||
+		strings.HasSuffix(r.URL.Path, "/log")
+}
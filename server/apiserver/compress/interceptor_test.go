@@ -0,0 +1,50 @@
+package compress
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterceptor(t *testing.T) {
+	body := strings.Repeat(`{"metadata":{"name":"my-wf"}},`, 1000)
+	handler := Interceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+
+	t.Run("Gzip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+		assert.Less(t, rr.Body.Len(), len(body))
+		reader, err := gzip.NewReader(rr.Body)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, body, string(data))
+	})
+	t.Run("NoAcceptEncoding", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo", nil)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Empty(t, rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, body, rr.Body.String())
+	})
+	t.Run("Stream", func(t *testing.T) {
+		for _, path := range []string{"/api/v1/workflow-events/argo", "/api/v1/stream/events/argo", "/api/v1/workflows/argo/my-wf/log"} {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Empty(t, rr.Header().Get("Content-Encoding"), path)
+			assert.Equal(t, body, rr.Body.String(), path)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package etag
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// getWorkflowPath matches `GET /api/v1/workflows/{namespace}/{name}`.
+var getWorkflowPath = regexp.MustCompile(`^/api/v1/workflows/[^/]+/[^/]+$`)
+
+// Interceptor returns a handler that adds an ETag to successful get-workflow responses and answers
+// `304 Not Modified` when the request's `If-None-Match` matches, making polling a finished workflow cheap.
+// The ETag is weak because it is computed before compression, so the gzip and identity encodings share it.
+func Interceptor(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !getWorkflowPath.MatchString(r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		bw := &bufferingWriter{header: http.Header{}, status: http.StatusOK}
+		h.ServeHTTP(bw, r)
+		for k, v := range bw.header {
+			w.Header()[k] = v
+		}
+		if bw.status != http.StatusOK {
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(bw.body.Bytes())
+			return
+		}
+		sum := sha256.Sum256(bw.body.Bytes())
+		tag := `W/"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", tag)
+		if noneMatch(r.Header.Values("If-None-Match"), tag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(bw.body.Bytes())
+	})
+}
+
+// noneMatch reports whether any entity tag in the If-None-Match values weakly matches tag, see RFC 9110 13.1.2
+func noneMatch(values []string, tag string) bool {
+	for _, v := range values {
+		for _, t := range strings.Split(v, ",") {
+			t = strings.TrimSpace(t)
+			if t == "*" || strings.TrimPrefix(t, "W/") == strings.TrimPrefix(tag, "W/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bufferingWriter holds the whole response, so the ETag can be computed before anything is sent.
+type bufferingWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferingWriter) Header() http.Header { return b.header }
+
+func (b *bufferingWriter) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+func (b *bufferingWriter) WriteHeader(v int) { b.status = v }
@@ -0,0 +1,119 @@
+package etag
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/server/apiserver/compress"
+)
+
+func TestInterceptor(t *testing.T) {
+	body := `{"metadata":{"name":"my-wf"},"status":{"phase":"Succeeded"}}`
+	handler := Interceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, body, rr.Body.String())
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	tag := rr.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(tag, `W/"`), tag)
+
+	t.Run("NotModified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+		req.Header.Set("If-None-Match", tag)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.String())
+		assert.Equal(t, tag, rr.Header().Get("ETag"))
+	})
+	t.Run("NotModifiedList", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+		req.Header.Set("If-None-Match", `"stale", `+strings.TrimPrefix(tag, "W/"))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+	})
+	t.Run("NotModifiedAny", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+		req.Header.Set("If-None-Match", "*")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+	})
+	t.Run("Modified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+		req.Header.Set("If-None-Match", `"stale"`)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, body, rr.Body.String())
+	})
+	t.Run("Error", func(t *testing.T) {
+		handler := Interceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/missing", nil)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Empty(t, rr.Header().Get("ETag"))
+	})
+	t.Run("OtherPath", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo", nil)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("ETag"))
+	})
+}
+
+// TestInterceptorWithCompression wires the interceptors as newHTTPServer does
+func TestInterceptorWithCompression(t *testing.T) {
+	body := strings.Repeat(`{"metadata":{"name":"my-wf"}},`, 100)
+	handler := compress.Interceptor(Interceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})))
+	get := func(acceptEncoding, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workflows/argo/my-wf", nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	identity := get("", "")
+	assert.Equal(t, body, identity.Body.String())
+	gzipped := get("gzip", "")
+	assert.Equal(t, "gzip", gzipped.Header().Get("Content-Encoding"))
+	reader, err := gzip.NewReader(gzipped.Body)
+	assert.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, body, string(data))
+
+	// both encodings are the same entity, so they share a weak tag
+	tag := identity.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(tag, `W/"`), tag)
+	assert.Equal(t, tag, gzipped.Header().Get("ETag"))
+
+	assert.Equal(t, http.StatusNotModified, get("gzip", tag).Code)
+	assert.Equal(t, http.StatusNotModified, get("", tag).Code)
+	assert.Equal(t, http.StatusOK, get("gzip", `W/"stale"`).Code)
+}
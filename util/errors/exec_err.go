@@ -1,6 +1,10 @@
 package errors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"syscall"
+)
 
 type Exited interface {
 	ExitCode() int
@@ -22,3 +26,9 @@ func (e execErr) ExitCode() int {
 func (e execErr) Error() string {
 	return fmt.Sprintf("exit status %d", e)
 }
+
+// IsExecFormatErr returns true if the error is because the file could not be executed, e.g. a binary built for a
+// different architecture to the node, or a script without a shebang.
+func IsExecFormatErr(err error) bool {
+	return errors.Is(err, syscall.ENOEXEC)
+}
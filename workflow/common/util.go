@@ -159,6 +159,9 @@ func substituteAndGetConfigMapValue(inParam *wfv1.Parameter, globalParams Parame
 		}
 	} else {
 		if inParam.Value == nil {
+			if inParam.Description != nil && inParam.Description.String() != "" {
+				return errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s was not supplied (%s)", inParam.Name, inParam.Description.String())
+			}
 			return errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s was not supplied", inParam.Name)
 		}
 	}
@@ -2613,6 +2613,72 @@ func TestExpandWithItemsMap(t *testing.T) {
 	assert.Equal(t, "debian 9.1 JSON({\"os\":\"debian\",\"version\":9.1})", newSteps[0].Arguments.Parameters[0].Value.String())
 }
 
+var expandWithItemsMapInline = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: expand-with-items-map-inline
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: fetch
+        inline:
+          container:
+            image: curlimages/curl:latest
+            command: [sh, -c]
+            args: ["curl -o /tmp/{{item.name}} {{item.url}}"]
+            env:
+            - name: NAME
+              value: "{{item.name}}"
+            - name: URL
+              value: "{{item.url}}"
+        withItems:
+        - {name: foo, url: "https://example.com/foo"}
+        - {name: bar, url: "https://example.com/bar"}
+`
+
+func TestExpandWithItemsMapInline(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(expandWithItemsMapInline)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+
+	urls := map[string]string{}
+	for _, pod := range pods.Items {
+		env := map[string]string{}
+		for _, c := range pod.Spec.Containers {
+			if c.Name != common.MainContainerName {
+				continue
+			}
+			for _, e := range c.Env {
+				env[e.Name] = e.Value
+			}
+			assert.Equal(t, []string{fmt.Sprintf("curl -o /tmp/%s %s", env["NAME"], env["URL"])}, c.Args)
+		}
+		urls[env["NAME"]] = env["URL"]
+	}
+	assert.Equal(t, map[string]string{"foo": "https://example.com/foo", "bar": "https://example.com/bar"}, urls)
+}
+
+func TestExpandWithItemsMapMissingKey(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(expandWithItemsMapInline)
+	wf.Spec.Templates[0].Steps[0].Steps[0].Inline.Container.Env[1].Value = "{{item.missing}}"
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	_, err := woc.expandStep(wf.Spec.Templates[0].Steps[0].Steps[0])
+	assert.EqualError(t, err, "failed to resolve {{item.missing}}")
+}
+
 var suspendTemplate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
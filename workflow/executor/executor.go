@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/argoproj/argo-workflows/v3/util/file"
 
@@ -777,10 +778,20 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 	}
 
 	const maxAnnotationSize int = 256 * (1 << 10) // 256 kB
-	// A character in a string is a byte
+	// the limit is in bytes, not characters
 	if len(out) > maxAnnotationSize {
 		log.Warnf("Output is larger than the maximum allowed size of 256 kB, only the last 256 kB were saved")
-		out = out[len(out)-maxAnnotationSize:]
+		start := len(out) - maxAnnotationSize
+		// do not start part way through a multi-byte character
+		for start < len(out) && !utf8.RuneStart(out[start]) {
+			start++
+		}
+		out = out[start:]
+	}
+	// the result is stored as a JSON string, which must be valid UTF-8, e.g. binary output is not
+	if !utf8.ValidString(out) {
+		log.Warnf("Output is not valid UTF-8 and was not saved as the result, write binary output to a file and use an output artifact instead")
+		return nil
 	}
 
 	we.Template.Outputs.Result = &out
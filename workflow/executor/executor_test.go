@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -560,6 +561,38 @@ func TestSaveLogs(t *testing.T) {
 	})
 }
 
+func TestCaptureScriptResult(t *testing.T) {
+	capture := func(t *testing.T, output string) *string {
+		mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+		mockRuntimeExecutor.On("GetOutputStream", mock.Anything, common.MainContainerName, false).Return(io.NopCloser(strings.NewReader(output)), nil)
+		we := WorkflowExecutor{
+			Template:            wfv1.Template{Script: &wfv1.ScriptTemplate{}},
+			RuntimeExecutor:     &mockRuntimeExecutor,
+			IncludeScriptOutput: true,
+		}
+		err := we.CaptureScriptResult(context.Background())
+		assert.NoError(t, err)
+		return we.Template.Outputs.Result
+	}
+	t.Run("Simple", func(t *testing.T) {
+		result := capture(t, "hello world\n")
+		if assert.NotNil(t, result) {
+			assert.Equal(t, "hello world", *result)
+		}
+	})
+	t.Run("Binary", func(t *testing.T) {
+		assert.Nil(t, capture(t, "hello\xff\xfeworld"))
+	})
+	t.Run("TruncatedMultiByte", func(t *testing.T) {
+		// "é" is two bytes, so cutting the last 256 kB would split the first one
+		result := capture(t, strings.Repeat("é", 128*(1<<10)+1))
+		if assert.NotNil(t, result) {
+			assert.True(t, utf8.ValidString(*result))
+			assert.Equal(t, strings.Repeat("é", 128*(1<<10)), *result)
+		}
+	})
+}
+
 func TestReportOutputs(t *testing.T) {
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
 	mockTaskResultClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace)
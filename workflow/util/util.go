@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"regexp"
 	nruntime "runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -296,15 +297,20 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 func overrideParameters(wf *wfv1.Workflow, parameters []string) error {
 	if len(parameters) > 0 {
 		newParams := make([]wfv1.Parameter, 0)
-		passedParams := make(map[string]bool)
+		passedParams := make(map[string]int)
 		for _, paramStr := range parameters {
 			parts := strings.SplitN(paramStr, "=", 2)
 			if len(parts) != 2 {
 				return fmt.Errorf("expected parameter of the form: NAME=VALUE. Received: %s", paramStr)
 			}
 			param := wfv1.Parameter{Name: parts[0], Value: wfv1.AnyStringPtr(parts[1])}
+			if i, ok := passedParams[param.Name]; ok {
+				// a later parameter overrides an earlier one with the same name
+				newParams[i] = param
+				continue
+			}
+			passedParams[param.Name] = len(newParams)
 			newParams = append(newParams, param)
-			passedParams[param.Name] = true
 		}
 		for _, param := range wf.Spec.Arguments.Parameters {
 			if _, ok := passedParams[param.Name]; ok {
@@ -342,15 +348,25 @@ func ReadParametersFile(file string, opts *wfv1.SubmitOpts) error {
 		return err
 	}
 
-	for k, v := range yamlParams {
+	names := make([]string, 0, len(yamlParams))
+	for k := range yamlParams {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	fileParams := make([]string, 0, len(yamlParams))
+	for _, k := range names {
+		v := yamlParams[k]
 		// We get quoted strings from the yaml file.
 		value, err := strconv.Unquote(string(v))
 		if err != nil {
 			// the string is already clean.
 			value = string(v)
 		}
-		opts.Parameters = append(opts.Parameters, fmt.Sprintf("%s=%s", k, value))
+		fileParams = append(fileParams, fmt.Sprintf("%s=%s", k, value))
 	}
+	// parameters already passed individually (e.g. `-p`) take precedence over the file
+	opts.Parameters = append(fileParams, opts.Parameters...)
 	return nil
 }
 
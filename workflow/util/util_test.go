@@ -609,6 +609,24 @@ func TestApplySubmitOpts(t *testing.T) {
 			assert.Equal(t, "81861780812", parameters[0].Value.String())
 		}
 	})
+	t.Run("OverriddenParameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("0")}, {Name: "b", Value: wfv1.AnyStringPtr("0")}},
+				},
+			},
+		}
+		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=1", "a=2"}})
+		assert.NoError(t, err)
+		parameters := wf.Spec.Arguments.Parameters
+		if assert.Len(t, parameters, 2) {
+			assert.Equal(t, "a", parameters[0].Name)
+			assert.Equal(t, "2", parameters[0].Value.String())
+			assert.Equal(t, "b", parameters[1].Name)
+			assert.Equal(t, "0", parameters[1].Value.String())
+		}
+	})
 	t.Run("PodPriorityClassName", func(t *testing.T) {
 		wf := &wfv1.Workflow{}
 		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{PodPriorityClassName: "abc"})
@@ -630,6 +648,23 @@ func TestReadParametersFile(t *testing.T) {
 	if assert.Len(t, parameters, 1) {
 		assert.Equal(t, "a=81861780812", parameters[0])
 	}
+
+	t.Run("ParametersTakePrecedence", func(t *testing.T) {
+		err = os.WriteFile(file.Name(), []byte("b: file\na: file"), 0o600)
+		assert.NoError(t, err)
+		opts := &wfv1.SubmitOpts{Parameters: []string{"a=flag"}}
+		err = ReadParametersFile(file.Name(), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a=file", "b=file", "a=flag"}, opts.Parameters)
+
+		wf := &wfv1.Workflow{}
+		err = ApplySubmitOpts(wf, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []wfv1.Parameter{
+			{Name: "a", Value: wfv1.AnyStringPtr("flag")},
+			{Name: "b", Value: wfv1.AnyStringPtr("file")},
+		}, wf.Spec.Arguments.Parameters)
+	})
 }
 
 func TestFormulateResubmitWorkflow(t *testing.T) {
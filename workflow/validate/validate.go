@@ -879,6 +879,9 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 		// check if any value is defined
 		if param.ValueFrom == nil && param.Value == nil {
 			if !allowEmptyValues {
+				if param.Description != nil && param.Description.String() != "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s%s.value or %s%s.valueFrom is required (%s)", prefix, param.Name, prefix, param.Name, param.Description.String())
+				}
 				return errors.Errorf(errors.CodeBadRequest, "%s%s.value or %s%s.valueFrom is required", prefix, param.Name, prefix, param.Name)
 			}
 		}
@@ -957,7 +960,14 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return err
 			}
-			resolvedTmpl, err := ctx.validateTemplateHolder(&step, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+			stepHolder := step
+			if step.Inline != nil {
+				stepHolder.Inline, err = substituteItemPlaceholders(step.Inline, step.WithItems, step.WithParam, step.WithSequence)
+				if err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
+			}
+			resolvedTmpl, err := ctx.validateTemplateHolder(&stepHolder, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
@@ -998,7 +1008,7 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			ctx.addOutputsToScope(resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, aggregate, false)
 
 			// Validate the template again with actual arguments.
-			_, err = ctx.validateTemplateHolder(&step, tmplCtx, &step.Arguments, workflowTemplateValidation)
+			_, err = ctx.validateTemplateHolder(&stepHolder, tmplCtx, &step.Arguments, workflowTemplateValidation)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
@@ -1053,6 +1063,48 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 	return nil
 }
 
+// substituteItemPlaceholders returns a copy of an inline template with its {{item}} references replaced by
+// placeholders. Items are only substituted when the step or task is expanded, so the inline template cannot
+// resolve them on its own during validation.
+func substituteItemPlaceholders(tmpl *wfv1.Template, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence) (*wfv1.Template, error) {
+	itemScope := make(map[string]interface{})
+	if err := addItemsToScope(withItems, withParam, withSequence, itemScope); err != nil {
+		return nil, err
+	}
+	if len(itemScope) == 0 {
+		return tmpl, nil
+	}
+	_, allowAllItemRefs := itemScope[anyItemMagicValue]
+	tmplBytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	replaceMap := make(map[string]string)
+	err = template.Validate(string(tmplBytes), func(tag string) error {
+		trimmedTag := strings.TrimSpace(tag)
+		if trimmedTag != "item" && !strings.HasPrefix(trimmedTag, "item.") {
+			return nil
+		}
+		if _, ok := itemScope[trimmedTag]; !ok && !allowAllItemRefs {
+			return fmt.Errorf("failed to resolve {{%s}}", tag)
+		}
+		replaceMap[trimmedTag] = placeholderGenerator.NextPlaceholder()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	newTmplStr, err := template.Replace(string(tmplBytes), replaceMap, true)
+	if err != nil {
+		return nil, err
+	}
+	var newTmpl wfv1.Template
+	if err := json.Unmarshal([]byte(newTmplStr), &newTmpl); err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return &newTmpl, nil
+}
+
 func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix string, scope map[string]interface{}, aggregate bool, isAncestor bool) {
 	scope[fmt.Sprintf("%s.id", prefix)] = true
 	scope[fmt.Sprintf("%s.startedAt", prefix)] = true
@@ -1334,6 +1386,7 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 	}
 
 	resolvedTemplates := make(map[string]*wfv1.Template)
+	taskHolders := make(map[string]wfv1.DAGTask)
 
 	// Verify dependencies for all tasks can be resolved as well as template names
 	for _, task := range tmpl.DAG.Tasks {
@@ -1350,7 +1403,16 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s cannot use 'continueOn' when using 'depends'. Instead use 'dep-task.Failed'/'dep-task.Errored'", tmpl.Name)
 		}
 
-		resolvedTmpl, err := ctx.validateTemplateHolder(&task, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+		taskHolder := task
+		if task.Inline != nil {
+			taskHolder.Inline, err = substituteItemPlaceholders(task.Inline, task.WithItems, task.WithParam, task.WithSequence)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+		}
+		taskHolders[task.Name] = taskHolder
+
+		resolvedTmpl, err := ctx.validateTemplateHolder(&taskHolder, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
 
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
@@ -1443,7 +1505,8 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
 		// Validate the template again with actual arguments.
-		_, err = ctx.validateTemplateHolder(&task, tmplCtx, &task.Arguments, workflowTemplateValidation)
+		taskHolder := taskHolders[task.Name]
+		_, err = ctx.validateTemplateHolder(&taskHolder, tmplCtx, &task.Arguments, workflowTemplateValidation)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
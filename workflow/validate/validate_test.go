@@ -506,6 +506,57 @@ func TestParamWithoutValue(t *testing.T) {
 	}
 }
 
+var paramWithoutValueWithDescription = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+        description: the message for the whale to say
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestParamWithoutValueWithDescription(t *testing.T) {
+	err := validate(paramWithoutValueWithDescription)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "inputs.parameters.message was not supplied (the message for the whale to say)")
+	}
+}
+
+var argumentWithoutValueWithDescription = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  entrypoint: whalesay
+  arguments:
+    parameters:
+    - name: message
+      description: the message for the whale to say
+  templates:
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestArgumentWithoutValueWithDescription(t *testing.T) {
+	err := validate(argumentWithoutValueWithDescription)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "spec.arguments.message.value or spec.arguments.message.valueFrom is required (the message for the whale to say)")
+	}
+}
+
 var globalParam = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1337,6 +1388,58 @@ func TestValidWithItems(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+var inlineWithItemsMap = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: loops-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: fetch
+        inline:
+          container:
+            image: curlimages/curl:latest
+            args: ["-o", "/tmp/{{item.name}}", "{{item.url}}"]
+            env:
+            - name: URL
+              value: "{{item.url}}"
+        withItems:
+        - {name: foo, url: "https://example.com/foo"}
+        - {name: bar, url: "https://example.com/bar"}
+    - - name: fetch-dag
+        template: dag
+  - name: dag
+    dag:
+      tasks:
+      - name: fetch
+        inline:
+          container:
+            image: curlimages/curl:latest
+            env:
+            - name: URL
+              value: "{{item.url}}"
+        withItems:
+        - {name: foo, url: "https://example.com/foo"}
+`
+
+func TestInlineWithItemsMap(t *testing.T) {
+	err := validate(inlineWithItemsMap)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(inlineWithItemsMap)
+	wf.Spec.Templates[0].Steps[0].Steps[0].Inline.Container.Env[0].Value = "{{item.missing}}"
+	err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.ErrorContains(t, err, "failed to resolve {{item.missing}}")
+
+	wf = unmarshalWf(inlineWithItemsMap)
+	wf.Spec.Templates[1].DAG.Tasks[0].Inline.Container.Env[0].Value = "{{item.missing}}"
+	err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.ErrorContains(t, err, "failed to resolve {{item.missing}}")
+}
+
 var podNameVariable = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow